@@ -0,0 +1,45 @@
+package palette
+
+import "image/color"
+
+// ColorPalette returns p's Entries as a color.Palette, suitable for use with
+// image.NewPaletted, draw.FloydSteinberg, and gif.Encode. The result is
+// cached on first call; it does not observe later mutations of Entries.
+func (p *Palette) ColorPalette() color.Palette {
+	if p.colorPalette == nil {
+		cp := make(color.Palette, len(p.Entries))
+		for i, entry := range p.Entries {
+			cp[i] = entry.Color
+		}
+		p.colorPalette = cp
+	}
+
+	return p.colorPalette
+}
+
+// Index returns the index of the Entries color nearest to c, per
+// color.Palette.Index.
+func (p *Palette) Index(c color.Color) int {
+	return p.ColorPalette().Index(c)
+}
+
+// Convert returns the Entries color nearest to c, per color.Palette.Convert.
+func (p *Palette) Convert(c color.Color) color.Color {
+	return p.ColorPalette().Convert(c)
+}
+
+// PaletteFromColorPalette builds a *Palette from a color.Palette, naming each
+// entry from entryNames by index. entryNames may be shorter than cp, or nil;
+// entries beyond its length are left unnamed.
+func PaletteFromColorPalette(name string, cp color.Palette, entryNames []string) *Palette {
+	entries := make([]PaletteEntry, len(cp))
+	for i, c := range cp {
+		var entryName string
+		if i < len(entryNames) {
+			entryName = entryNames[i]
+		}
+		entries[i] = PaletteEntry{entryName, c}
+	}
+
+	return &Palette{Name: name, Entries: entries}
+}
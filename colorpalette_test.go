@@ -0,0 +1,66 @@
+package palette
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorPalette(t *testing.T) {
+	p, err := ReadPalette(strings.NewReader(validPaletteStrict), ParsingModeStrict)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	t.Run("ColorPalette mirrors Entries", func(t *testing.T) {
+		cp := p.ColorPalette()
+		if assert.Len(t, cp, len(p.Entries)) {
+			for i, entry := range p.Entries {
+				assert.Equal(t, entry.Color, cp[i])
+			}
+		}
+	})
+
+	t.Run("Index and Convert find the nearest entry", func(t *testing.T) {
+		assert.Equal(t, 2, p.Index(color.RGBA{250, 250, 250, 255}))
+		assert.Equal(t, color.Color(color.RGBA{255, 255, 255, 255}), p.Convert(color.RGBA{250, 250, 250, 255}))
+	})
+
+	t.Run("quantizes an image.RGBA into an image.Paletted", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 2, 1))
+		src.Set(0, 0, color.RGBA{0, 127, 255, 255})
+		src.Set(1, 0, color.RGBA{255, 255, 255, 255})
+
+		dst := image.NewPaletted(src.Bounds(), p.ColorPalette())
+		draw.Draw(dst, dst.Bounds(), src, image.Point{}, draw.Src)
+
+		assert.Equal(t, uint8(0), dst.ColorIndexAt(0, 0))
+		assert.Equal(t, uint8(2), dst.ColorIndexAt(1, 0))
+	})
+}
+
+func TestPaletteFromColorPalette(t *testing.T) {
+	cp := color.Palette{
+		color.RGBA{0, 0, 0, 255},
+		color.RGBA{255, 255, 255, 255},
+	}
+
+	t.Run("names entries by index", func(t *testing.T) {
+		p := PaletteFromColorPalette("From color.Palette", cp, []string{"black"})
+		assert.Equal(t, "From color.Palette", p.Name)
+		assert.Equal(t, []PaletteEntry{
+			{"black", color.RGBA{0, 0, 0, 255}},
+			{"", color.RGBA{255, 255, 255, 255}},
+		}, p.Entries)
+	})
+
+	t.Run("nil entryNames leaves entries unnamed", func(t *testing.T) {
+		p := PaletteFromColorPalette("Unnamed", cp, nil)
+		assert.Equal(t, "", p.Entries[0].Name)
+		assert.Equal(t, "", p.Entries[1].Name)
+	})
+}
@@ -0,0 +1,70 @@
+package palette
+
+import (
+	"bufio"
+	"errors"
+	"io"
+)
+
+var errUnknownFormat = errors.New("unrecognized palette format")
+
+// Decoder parses a palette file in a particular format.
+type Decoder func(io.Reader, ParsingMode) (*Palette, error)
+
+// Encoder writes a *Palette out in a particular format.
+type Encoder func(io.Writer, *Palette, ParsingMode) error
+
+type paletteFormat struct {
+	name   string
+	magic  string
+	decode Decoder
+	encode Encoder
+}
+
+var formats []paletteFormat
+
+// RegisterFormat registers a palette format for use by Decode and
+// DecodeConfig. name is a short identifier for the format (e.g. "gpl"),
+// magic is the leading bytes that identify it, and d/e are the format's
+// Decoder and Encoder. Typically called from an init function.
+func RegisterFormat(name, magic string, d Decoder, e Encoder) {
+	formats = append(formats, paletteFormat{name, magic, d, e})
+}
+
+// Decode sniffs r's leading bytes against every registered format's magic
+// and decodes it under lenient mode, returning the format's registered
+// name alongside the parsed palette.
+func Decode(r io.Reader) (*Palette, string, error) {
+	br := bufio.NewReader(r)
+
+	for _, f := range formats {
+		peeked, err := br.Peek(len(f.magic))
+		if err != nil || string(peeked) != f.magic {
+			continue
+		}
+
+		p, err := f.decode(br, ParsingModeLenient)
+		return p, f.name, err
+	}
+
+	return nil, "", errUnknownFormat
+}
+
+// PaletteConfig holds the headline metadata of a palette file, without its
+// entry data.
+type PaletteConfig struct {
+	Name       string
+	Columns    int
+	NumEntries int
+}
+
+// DecodeConfig sniffs and decodes r like Decode, but returns only the
+// resulting palette's Name, Columns, and entry count.
+func DecodeConfig(r io.Reader) (PaletteConfig, string, error) {
+	p, name, err := Decode(r)
+	if err != nil {
+		return PaletteConfig{}, name, err
+	}
+
+	return PaletteConfig{Name: p.Name, Columns: p.Columns, NumEntries: len(p.Entries)}, name, nil
+}
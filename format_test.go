@@ -0,0 +1,55 @@
+package palette
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Run("sniffs GPL", func(t *testing.T) {
+		p, format, err := Decode(strings.NewReader(validPaletteStrict))
+		assert.NoError(t, err)
+		assert.Equal(t, "gpl", format)
+		if assert.NotNil(t, p) {
+			assert.Equal(t, "Valid Palette (Strict)", p.Name)
+		}
+	})
+
+	t.Run("sniffs JASC-PAL", func(t *testing.T) {
+		p, format, err := Decode(strings.NewReader(validJASCPAL))
+		assert.NoError(t, err)
+		assert.Equal(t, "jasc-pal", format)
+		if assert.NotNil(t, p) {
+			assert.Len(t, p.Entries, 3)
+		}
+	})
+
+	t.Run("unrecognized format", func(t *testing.T) {
+		p, format, err := Decode(strings.NewReader("not a palette"))
+		assert.ErrorIs(t, err, errUnknownFormat)
+		assert.Equal(t, "", format)
+		assert.Nil(t, p)
+	})
+}
+
+func TestDecodeConfig(t *testing.T) {
+	t.Run("GPL", func(t *testing.T) {
+		cfg, format, err := DecodeConfig(strings.NewReader(validPaletteStrict))
+		assert.NoError(t, err)
+		assert.Equal(t, "gpl", format)
+		assert.Equal(t, PaletteConfig{
+			Name:       "Valid Palette (Strict)",
+			Columns:    2,
+			NumEntries: 4,
+		}, cfg)
+	})
+
+	t.Run("JASC-PAL", func(t *testing.T) {
+		cfg, format, err := DecodeConfig(strings.NewReader(validJASCPAL))
+		assert.NoError(t, err)
+		assert.Equal(t, "jasc-pal", format)
+		assert.Equal(t, PaletteConfig{NumEntries: 3}, cfg)
+	})
+}
@@ -0,0 +1,178 @@
+package palette
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+var errBadJASCHeader = errors.New("missing JASC-PAL magic header")
+var errBadJASCVersion = errors.New("missing JASC-PAL version line")
+var errBadJASCCount = errors.New("missing or malformed JASC-PAL color count")
+
+const jascMagicHeader = "JASC-PAL"
+const jascVersionLine = "0100"
+
+func init() {
+	RegisterFormat("jasc-pal", jascMagicHeader, DecodeJASCPAL, EncodeJASCPAL)
+}
+
+// DecodeJASCPAL parses a Paint Shop Pro JASC-PAL file. JASC-PAL has no
+// Name or Columns concept, so the returned Palette only ever populates
+// Entries.
+func DecodeJASCPAL(r io.Reader, parsingMode ParsingMode) (p *Palette, err error) {
+	done := make(chan struct{})
+
+	linesCh, linesErrCh := readLines(done, r)
+
+	defer multierr.AppendInvoke(&err, multierr.Invoke(func() (err error) {
+		for linesErr := range linesErrCh {
+			multierr.AppendInto(&err, linesErr)
+		}
+		return
+	}),
+	)
+
+	defer close(done)
+
+	if line, ok := <-linesCh; !ok || strings.TrimSpace(line) != jascMagicHeader {
+		multierr.AppendInto(&err, errBadJASCHeader)
+		return
+	}
+
+	if line, ok := <-linesCh; !ok || strings.TrimSpace(line) != jascVersionLine {
+		if parsingMode == ParsingModeStrict {
+			multierr.AppendInto(&err, errBadJASCVersion)
+			return
+		}
+		if ok {
+			linesCh = putBack(line, linesCh)
+		}
+	}
+
+	var count int
+	if line, ok := <-linesCh; !ok {
+		multierr.AppendInto(&err, errBadJASCCount)
+		return
+	} else if n, convErr := strconv.Atoi(strings.TrimSpace(line)); convErr != nil {
+		if parsingMode == ParsingModeStrict {
+			multierr.AppendInto(&err, fmt.Errorf("%w: %v", errBadJASCCount, convErr))
+			return
+		}
+	} else {
+		count = n
+	}
+
+	entries := []PaletteEntry{}
+	for line := range linesCh {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		entry, parseErr := parseJASCRow(line, parsingMode)
+		if parseErr != nil {
+			multierr.AppendInto(&err, parseErr)
+			return
+		}
+		entries = append(entries, *entry)
+	}
+
+	if parsingMode == ParsingModeStrict && count != len(entries) {
+		multierr.AppendInto(&err, fmt.Errorf("%w: header declared %d, found %d", errBadJASCCount, count, len(entries)))
+		return
+	}
+
+	p = &Palette{Entries: entries}
+
+	return
+}
+
+func parseJASCRow(line string, parsingMode ParsingMode) (entry *PaletteEntry, err error) {
+	fields := strings.Fields(line)
+
+	if parsingMode == ParsingModeStrict && len(fields) != 3 {
+		multierr.AppendInto(&err, errMissingField)
+		return
+	}
+
+	c := color.RGBA{A: 255}
+
+	var fieldErr error
+	processField := func(field string) uint8 {
+		var v int
+		if _, err := fmt.Sscanf(field, "%d", &v); err != nil {
+			if parsingMode == ParsingModeStrict {
+				multierr.AppendInto(&fieldErr, errMalformedRow)
+			}
+		}
+		if v < 0 || v > 255 {
+			if parsingMode == ParsingModeStrict {
+				multierr.AppendInto(&fieldErr, errOutOfRange)
+			}
+			v = int(math.Trunc(math.Max(0., math.Min(255., float64(v)))))
+		}
+		return uint8(v & 0xFF)
+	}
+
+	if len(fields) >= 1 {
+		c.R = processField(fields[0])
+	}
+	if len(fields) >= 2 {
+		c.G = processField(fields[1])
+	}
+	if len(fields) >= 3 {
+		c.B = processField(fields[2])
+	}
+
+	if multierr.AppendInto(&err, fieldErr) {
+		return
+	}
+
+	entry = &PaletteEntry{Color: c}
+
+	return
+}
+
+// EncodeJASCPAL writes p out as a JASC-PAL file. Name, Columns, and
+// Comments have no JASC-PAL equivalent and are silently dropped.
+func EncodeJASCPAL(w io.Writer, p *Palette, parsingMode ParsingMode) (err error) {
+	rows := make([]string, len(p.Entries))
+	for i, entry := range p.Entries {
+		r, g, b, rowErr := quantizeChannels(entry.Color, parsingMode)
+		if rowErr != nil {
+			multierr.AppendInto(&err, rowErr)
+			return
+		}
+		rows[i] = fmt.Sprintf("%d %d %d", r, g, b)
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if _, err = fmt.Fprintln(bw, jascMagicHeader); err != nil {
+		return
+	}
+	if _, err = fmt.Fprintln(bw, jascVersionLine); err != nil {
+		return
+	}
+	if _, err = fmt.Fprintln(bw, len(p.Entries)); err != nil {
+		return
+	}
+
+	for _, row := range rows {
+		if _, err = fmt.Fprintln(bw, row); err != nil {
+			return
+		}
+	}
+
+	err = bw.Flush()
+
+	return
+}
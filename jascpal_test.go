@@ -0,0 +1,99 @@
+package palette
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const validJASCPAL = `JASC-PAL
+0100
+3
+0 127 255
+0 0 0
+255 255 255
+`
+
+const jascPALBadCount = `JASC-PAL
+0100
+99
+0 127 255
+`
+
+const jascPALMissingVersion = `JASC-PAL
+3
+0 127 255
+`
+
+func TestDecodeJASCPAL(t *testing.T) {
+	t.Run("valid file is parsed under strict validation", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(validJASCPAL), ParsingModeStrict)
+		assert.NoError(t, err)
+		if assert.NotNil(t, p) {
+			assert.Equal(t, []PaletteEntry{
+				{"", color.RGBA{0, 127, 255, 255}},
+				{"", color.RGBA{0, 0, 0, 255}},
+				{"", color.RGBA{255, 255, 255, 255}},
+			}, p.Entries)
+		}
+	})
+
+	t.Run("mismatched count is rejected under strict validation", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(jascPALBadCount), ParsingModeStrict)
+		assert.ErrorIs(t, err, errBadJASCCount)
+		assert.Nil(t, p)
+	})
+
+	t.Run("mismatched count is tolerated under lenient validation", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(jascPALBadCount), ParsingModeLenient)
+		assert.NoError(t, err)
+		if assert.NotNil(t, p) {
+			assert.Len(t, p.Entries, 1)
+		}
+	})
+
+	t.Run("missing version line is rejected under strict validation", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(jascPALMissingVersion), ParsingModeStrict)
+		assert.ErrorIs(t, err, errBadJASCVersion)
+		assert.Nil(t, p)
+	})
+
+	t.Run("missing version line is tolerated under lenient validation", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(jascPALMissingVersion), ParsingModeLenient)
+		assert.NoError(t, err)
+		if assert.NotNil(t, p) {
+			assert.Len(t, p.Entries, 1)
+		}
+	})
+
+	t.Run("bad header returns error", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader("foobar"), ParsingModeStrict)
+		assert.ErrorIs(t, err, errBadJASCHeader)
+		assert.Nil(t, p)
+	})
+}
+
+func TestEncodeJASCPAL(t *testing.T) {
+	t.Run("round trip parity", func(t *testing.T) {
+		p, err := DecodeJASCPAL(strings.NewReader(validJASCPAL), ParsingModeStrict)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		var buf bytes.Buffer
+		assert.NoError(t, EncodeJASCPAL(&buf, p, ParsingModeStrict))
+
+		p2, err := DecodeJASCPAL(&buf, ParsingModeStrict)
+		assert.NoError(t, err)
+		assert.Equal(t, p, p2)
+	})
+
+	t.Run("strict validation rejects out of range channel", func(t *testing.T) {
+		p := &Palette{Entries: []PaletteEntry{{"", badColor{}}}}
+		err := EncodeJASCPAL(&bytes.Buffer{}, p, ParsingModeStrict)
+		assert.ErrorIs(t, err, errOutOfRange)
+	})
+}
@@ -7,6 +7,8 @@ type Palette struct {
 	Columns  int
 	Comments []string
 	Entries  []PaletteEntry
+
+	colorPalette color.Palette
 }
 
 type PaletteEntry struct {
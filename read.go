@@ -21,6 +21,10 @@ var errMalformedRow = errors.New("row is malformed")
 
 const magicHeader = "GIMP Palette"
 
+func init() {
+	RegisterFormat("gpl", magicHeader, ReadPalette, WritePalette)
+}
+
 type ParsingMode int
 
 const (
@@ -102,7 +106,7 @@ func ReadPalette(r io.Reader, parsingMode ParsingMode) (p *Palette, err error) {
 		}
 	}
 
-	p = &Palette{name, columns, comments, entries}
+	p = &Palette{Name: name, Columns: columns, Comments: comments, Entries: entries}
 
 	return
 }
@@ -0,0 +1,141 @@
+package palette
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+
+	"go.uber.org/multierr"
+)
+
+var errNegativeColumns = errors.New("negative palette columns")
+
+// WritePalette encodes p as a GIMP .gpl file to w.
+//
+// Under strict mode an empty Name, a negative Columns, or an entry whose
+// Color yields non-alpha channels outside 0-255 after RGBA() is rejected.
+// Under lenient mode out-of-range channels are clamped, a negative Columns
+// is clamped to 0, and the Name/Columns header lines are omitted entirely
+// when the corresponding field is unset (empty Name, zero Columns).
+func WritePalette(w io.Writer, p *Palette, parsingMode ParsingMode) (err error) {
+	columns := p.Columns
+
+	if parsingMode == ParsingModeStrict {
+		if p.Name == "" {
+			multierr.AppendInto(&err, errMissingName)
+		}
+		if columns < 0 {
+			multierr.AppendInto(&err, errNegativeColumns)
+		}
+	} else if columns < 0 {
+		columns = 0
+	}
+	if err != nil {
+		return
+	}
+
+	rows := make([]string, len(p.Entries))
+	for i, entry := range p.Entries {
+		row, rowErr := formatRow(entry, parsingMode)
+		if rowErr != nil {
+			multierr.AppendInto(&err, rowErr)
+			return
+		}
+		rows[i] = row
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if _, err = fmt.Fprintln(bw, magicHeader); err != nil {
+		return
+	}
+
+	if p.Name != "" || parsingMode == ParsingModeStrict {
+		if _, err = fmt.Fprintf(bw, "Name: %s\n", p.Name); err != nil {
+			return
+		}
+	}
+
+	if columns != 0 || parsingMode == ParsingModeStrict {
+		if _, err = fmt.Fprintf(bw, "Columns: %d\n", columns); err != nil {
+			return
+		}
+	}
+
+	for _, comment := range p.Comments {
+		if _, err = fmt.Fprintf(bw, "#%s\n", comment); err != nil {
+			return
+		}
+	}
+
+	for _, row := range rows {
+		if _, err = fmt.Fprintln(bw, row); err != nil {
+			return
+		}
+	}
+
+	err = bw.Flush()
+
+	return
+}
+
+func formatRow(entry PaletteEntry, parsingMode ParsingMode) (row string, err error) {
+	r, g, b, err := quantizeChannels(entry.Color, parsingMode)
+	if err != nil {
+		return
+	}
+
+	if entry.Name != "" {
+		row = fmt.Sprintf("%d %d %d %s", r, g, b, entry.Name)
+	} else {
+		row = fmt.Sprintf("%d %d %d", r, g, b)
+	}
+
+	return
+}
+
+// quantizeChannels reduces c's non-alpha channels to 8 bits via RGBA(). Under
+// strict mode a channel that does not fit in 0-255 is rejected; under
+// lenient mode it is clamped. In practice this only guards against
+// color.Color implementations that violate the documented RGBA() contract.
+func quantizeChannels(c color.Color, parsingMode ParsingMode) (r, g, b uint8, err error) {
+	r32, g32, b32, _ := c.RGBA()
+
+	var fieldErr error
+	channel := func(v uint32) uint8 {
+		v8 := v >> 8
+		if v8 > 255 {
+			if parsingMode == ParsingModeStrict {
+				multierr.AppendInto(&fieldErr, errOutOfRange)
+			}
+			v8 = 255
+		}
+		return uint8(v8)
+	}
+
+	r, g, b = channel(r32), channel(g32), channel(b32)
+	multierr.AppendInto(&err, fieldErr)
+
+	return
+}
+
+// WriteTo encodes p as a GIMP .gpl file under lenient validation, satisfying
+// io.WriterTo.
+func (p *Palette) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := WritePalette(cw, p, ParsingModeLenient)
+	return cw.n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(buf []byte) (int, error) {
+	n, err := c.w.Write(buf)
+	c.n += int64(n)
+	return n, err
+}
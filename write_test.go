@@ -0,0 +1,110 @@
+package palette
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePalette(t *testing.T) {
+	t.Run("round trip parity", func(t *testing.T) {
+		t.Run("strict", func(t *testing.T) {
+			p, err := ReadPalette(strings.NewReader(validPaletteStrict), ParsingModeStrict)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var buf bytes.Buffer
+			assert.NoError(t, WritePalette(&buf, p, ParsingModeStrict))
+
+			p2, err := ReadPalette(&buf, ParsingModeStrict)
+			assert.NoError(t, err)
+			assert.Equal(t, p, p2)
+		})
+
+		t.Run("lenient, no columns", func(t *testing.T) {
+			p, err := ReadPalette(strings.NewReader(validPaletteNoColumns), ParsingModeLenient)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var buf bytes.Buffer
+			assert.NoError(t, WritePalette(&buf, p, ParsingModeLenient))
+			assert.NotContains(t, buf.String(), "Columns:")
+
+			p2, err := ReadPalette(&buf, ParsingModeLenient)
+			assert.NoError(t, err)
+			assert.Equal(t, p, p2)
+		})
+	})
+
+	t.Run("strict validation rejects empty name", func(t *testing.T) {
+		p := &Palette{Columns: 1}
+		err := WritePalette(&bytes.Buffer{}, p, ParsingModeStrict)
+		assert.ErrorIs(t, err, errMissingName)
+	})
+
+	t.Run("strict validation rejects negative columns", func(t *testing.T) {
+		p := &Palette{Name: "Negative Columns", Columns: -1}
+		err := WritePalette(&bytes.Buffer{}, p, ParsingModeStrict)
+		assert.ErrorIs(t, err, errNegativeColumns)
+	})
+
+	t.Run("lenient validation clamps negative columns", func(t *testing.T) {
+		p := &Palette{Name: "Negative Columns", Columns: -1}
+		var buf bytes.Buffer
+		assert.NoError(t, WritePalette(&buf, p, ParsingModeLenient))
+		assert.NotContains(t, buf.String(), "Columns:")
+	})
+
+	t.Run("comments are written as # prefixed lines", func(t *testing.T) {
+		p := &Palette{Name: "Commented", Columns: 1, Comments: []string{" a comment"}}
+		var buf bytes.Buffer
+		assert.NoError(t, WritePalette(&buf, p, ParsingModeStrict))
+		assert.Contains(t, buf.String(), "# a comment\n")
+	})
+
+	t.Run("strict validation rejects out of range channel", func(t *testing.T) {
+		p := &Palette{
+			Name:    "Bad Color",
+			Columns: 1,
+			Entries: []PaletteEntry{{"entry", badColor{}}},
+		}
+		err := WritePalette(&bytes.Buffer{}, p, ParsingModeStrict)
+		assert.ErrorIs(t, err, errOutOfRange)
+	})
+
+	t.Run("lenient validation clamps out of range channel", func(t *testing.T) {
+		p := &Palette{
+			Name:    "Bad Color",
+			Columns: 1,
+			Entries: []PaletteEntry{{"entry", badColor{}}},
+		}
+		var buf bytes.Buffer
+		assert.NoError(t, WritePalette(&buf, p, ParsingModeLenient))
+		assert.Contains(t, buf.String(), "255 255 255 entry\n")
+	})
+
+	t.Run("WriteTo satisfies io.WriterTo", func(t *testing.T) {
+		p := &Palette{Name: "Via WriteTo", Columns: 1}
+		var buf bytes.Buffer
+		n, err := p.WriteTo(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+		assert.Contains(t, buf.String(), "Name: Via WriteTo\n")
+	})
+}
+
+// badColor reports RGBA() values outside the 16-bit range that color.Color
+// implementations are expected to respect, to exercise the writer's
+// out-of-range handling independent of ReadPalette's own clamping.
+type badColor struct{}
+
+func (badColor) RGBA() (r, g, b, a uint32) {
+	return 1 << 20, 1 << 20, 1 << 20, 0xffff
+}
+
+var _ color.Color = badColor{}